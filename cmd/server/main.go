@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo"
+	"github.com/labstack/echo/middleware"
+
+	"github.com/sampila/simple-server/internal/config"
+	"github.com/sampila/simple-server/internal/fetch"
+	"github.com/sampila/simple-server/internal/handler"
+	appmiddleware "github.com/sampila/simple-server/internal/middleware"
+	"github.com/sampila/simple-server/internal/service"
+	"github.com/sampila/simple-server/internal/transport"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	cfg, err := config.Load("config.yaml")
+	if err != nil {
+		panic(err)
+	}
+
+	r := echo.New()
+	r.HTTPErrorHandler = transport.JSONErrorHandler
+	r.Validator = &transport.CustomValidator{Validator: validator.New()}
+	r.Binder = &transport.WordCountBinder{}
+
+	r.Use(middleware.Recover())
+	r.Use(appmiddleware.RequestID())
+	r.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Format: "method=${method}, uri=${uri}, status=${status} latency=${latency_human} in:${bytes_in} out:${bytes_out}\n",
+	}))
+	r.Use(middleware.GzipWithConfig(middleware.GzipConfig{
+		Level: cfg.GzipLevel,
+	}))
+	r.Use(middleware.CORS())
+	r.Use(appmiddleware.RateLimiter(cfg.RateLimit))
+
+	if cfg.Auth.Mode != "none" {
+		r.Use(appmiddleware.Auth(cfg.Auth))
+	}
+
+	remoteFetcher := fetch.New(fetch.DefaultTimeout, fetch.DefaultMaxBytes)
+	h := handler.New(service.New(), remoteFetcher)
+	r.POST("/top-ten-words", h.TopTenWords)
+	r.GET("/top-ten-words", h.TopTenWords)
+	r.POST("/top-k-words", h.TopKWords)
+	r.POST("/top-ten-words/bulk", h.BulkTopWords)
+
+	go func() {
+		if err := r.Start(":" + strconv.Itoa(cfg.Port)); err != nil {
+			r.Logger.Info("shutting down: ", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := r.Shutdown(ctx); err != nil {
+		r.Logger.Fatal(err)
+	}
+}
@@ -0,0 +1,68 @@
+// Package config loads the server's config.yaml, applying sane defaults
+// so a missing or partial file still produces a runnable configuration.
+package config
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the top-level server configuration.
+type Config struct {
+	Port      int             `yaml:"port"`
+	GzipLevel int             `yaml:"gzip_level"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Auth      AuthConfig      `yaml:"auth"`
+}
+
+// RateLimitConfig configures the per-IP token-bucket rate limiter.
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+}
+
+// AuthConfig configures request authentication. Mode is one of "none",
+// "token" (a static bearer token), or "jwt" (a signed JWT). Secret is never
+// read from the YAML file itself; it's loaded from the environment
+// variable named by SecretEnv so it never ends up committed alongside
+// config.yaml.
+type AuthConfig struct {
+	Mode      string `yaml:"mode"`
+	SecretEnv string `yaml:"secret_env"`
+	Secret    string `yaml:"-"`
+}
+
+func defaults() Config {
+	return Config{
+		Port:      9000,
+		GzipLevel: 5,
+		RateLimit: RateLimitConfig{RequestsPerSecond: 10, Burst: 20},
+		Auth:      AuthConfig{Mode: "none"},
+	}
+}
+
+// Load reads path, overlaying its values onto the defaults, and resolves
+// Auth.Secret from the environment. A missing file is not an error: the
+// server still runs with defaults.
+func Load(path string) (Config, error) {
+	cfg := defaults()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return Config{}, err
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, err
+	}
+
+	if cfg.Auth.SecretEnv != "" {
+		cfg.Auth.Secret = os.Getenv(cfg.Auth.SecretEnv)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,123 @@
+// Package fetch provides a reusable http.Client wrapper for downloading
+// remote text with a bounded timeout and a bounded read size. The single
+// ?url= form field and the bulk ingestion endpoint each hold their own
+// Client (one in package transport, one built in cmd/server) and reuse it
+// across requests.
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds how long a single fetch is allowed to take.
+	DefaultTimeout = 5 * time.Second
+	// DefaultMaxBytes caps how much of a response body is read.
+	DefaultMaxBytes = 10 * 1024 * 1024 // 10MB
+)
+
+// Client fetches remote text over HTTP, reusing one http.Client across
+// calls per Go's documented recommendation.
+type Client struct {
+	http     *http.Client
+	maxBytes int64
+}
+
+// New builds a Client with the given timeout and maximum response size.
+// Its transport dials through dialPublic, so requests can't be used to
+// reach loopback, private, or link-local addresses (SSRF via a
+// user-supplied URL, including ones that resolve there through DNS).
+func New(timeout time.Duration, maxBytes int64) *Client {
+	dialer := &net.Dialer{}
+	return &Client{
+		http: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext: dialPublic(dialer),
+			},
+		},
+		maxBytes: maxBytes,
+	}
+}
+
+// dialPublic wraps dialer so every connection it makes is checked against
+// the resolved IP, rejecting loopback, private, link-local, and other
+// non-public addresses after DNS resolution rather than before it - which
+// is what keeps a hostname that resolves to an internal address from
+// slipping through.
+func dialPublic(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			if !isPublicIP(ip) {
+				return nil, fmt.Errorf("refusing to fetch from non-public address %s", ip)
+			}
+		}
+
+		return dialer.DialContext(ctx, network, net.JoinHostPort(ips[0].String(), port))
+	}
+}
+
+// isPublicIP reports whether ip is safe to let a server-side fetch reach:
+// not loopback, private, link-local, unspecified, or multicast.
+func isPublicIP(ip net.IP) bool {
+	switch {
+	case ip.IsLoopback(), ip.IsPrivate(), ip.IsLinkLocalUnicast(), ip.IsLinkLocalMulticast(),
+		ip.IsUnspecified(), ip.IsMulticast():
+		return false
+	default:
+		return true
+	}
+}
+
+// allowedSchemes are the only URL schemes Fetch will dial.
+var allowedSchemes = map[string]bool{"http": true, "https": true}
+
+// Fetch downloads url and returns at most c.maxBytes of its body. Non-2xx
+// responses are reported as an error rather than returned as text. Only
+// http/https URLs are allowed, and the connection is refused if it would
+// reach a non-public address.
+func (c *Client) Fetch(ctx context.Context, rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("parsing url: %w", err)
+	}
+	if !allowedSchemes[parsed.Scheme] {
+		return "", fmt.Errorf("unsupported url scheme %q", parsed.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("fetching %s: unexpected status %d", rawURL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxBytes))
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,189 @@
+// Package handler wires Echo routes to the service layer. Handlers stay
+// thin: bind, validate, delegate to service.Service, render.
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo"
+
+	"github.com/sampila/simple-server/internal/service"
+	"github.com/sampila/simple-server/internal/transport"
+)
+
+// Handler holds the dependencies shared by the word-count routes.
+type Handler struct {
+	svc     service.Service
+	fetcher service.Fetcher
+}
+
+// New builds a Handler backed by svc, fetching remote documents (for
+// ?url= and the bulk endpoint) through fetcher.
+func New(svc service.Service, fetcher service.Fetcher) *Handler {
+	return &Handler{svc: svc, fetcher: fetcher}
+}
+
+/** route /top-ten-words accepts `text` (or `file`/`url`) plus tokenizer
+  options and returns the top-K most used words.
+  Response JSON example:
+  {
+    "success": true,
+    "data": [
+        {"word": "Go", "total": 2},
+        {"word": "programming", "total": 1}
+    ],
+    "total": 2
+  } **/
+
+// TopTenWords binds a WordCountRequest, optionally streams it through the
+// approximate Space-Saving path when ?algo=space-saving is set, and
+// otherwise returns the exact top-K.
+func (h *Handler) TopTenWords(ctx echo.Context) error {
+	form := new(transport.WordCountRequest)
+	if err := ctx.Bind(form); err != nil {
+		return err
+	}
+	if err := ctx.Validate(form); err != nil {
+		return err
+	}
+
+	opts := service.Options{
+		K:                form.K,
+		CaseInsensitive:  form.CaseInsensitive,
+		StripPunctuation: form.StripPunctuation,
+		MinLength:        form.MinLength,
+		Stopwords:        form.Stopwords,
+		Locale:           form.Locale,
+	}
+
+	var (
+		result service.Result
+		err    error
+	)
+	if ctx.QueryParam("algo") == "space-saving" {
+		result, err = h.svc.TopKWordsStream(ctx.Request().Context(), strings.NewReader(form.Text), opts.K)
+	} else {
+		result, err = h.svc.TopWords(ctx.Request().Context(), form.Text, opts)
+	}
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, transport.SuccessResponse{
+		Success: true,
+		Data:    result.Items,
+		Total:   result.Total,
+	})
+}
+
+/** route /top-k-words streams the request body through the Space-Saving
+  algorithm (Metwally et al.) instead of buffering it into a map, giving
+  O(N) time and O(k/epsilon) memory for large inputs. Accepts `?k=` to
+  override the default of 10. Each returned item carries `error`, the
+  amount its count could be overestimated by, so callers can compute the
+  guaranteed lower bound `total-error`. **/
+
+// TopKWords streams the raw request body through the Space-Saving
+// algorithm, bypassing JSON/XML binding entirely so arbitrarily large
+// bodies never have to be buffered.
+func (h *Handler) TopKWords(ctx echo.Context) error {
+	k := 10
+	if kParam := ctx.QueryParam("k"); kParam != "" {
+		if parsed, err := strconv.Atoi(kParam); err == nil && parsed > 0 {
+			k = parsed
+		}
+	}
+
+	result, err := h.svc.TopKWordsStream(ctx.Request().Context(), ctx.Request().Body, k)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, transport.SuccessResponse{
+		Success: true,
+		Data:    result.Items,
+		Total:   result.Total,
+	})
+}
+
+/** route /top-ten-words/bulk accepts either a JSON `{"urls": [...]}` body
+  or a multipart OPML file upload, fetches every URL concurrently (bounded
+  worker pool, default 8), and returns the merged top-K across all
+  documents plus each document's own breakdown. URLs that fail to fetch
+  are reported in a `failures` array rather than failing the whole batch.
+  With `?stream=true`, each completed URL is emitted immediately as one
+  newline-delimited JSON progress event instead of waiting for the batch
+  to finish. **/
+
+// BulkTopWords fetches every URL in the request concurrently, merges
+// their word counts, and renders either a single JSON response or an
+// ndjson progress stream depending on ?stream=true.
+func (h *Handler) BulkTopWords(ctx echo.Context) error {
+	req, err := transport.ParseBulkRequest(ctx)
+	if err != nil {
+		return err
+	}
+	if len(req.URLs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "no urls provided")
+	}
+
+	opts := service.BulkOptions{
+		Options:     service.Options{K: req.K},
+		Concurrency: req.Concurrency,
+	}
+
+	if ctx.QueryParam("stream") != "true" {
+		result, err := h.svc.Bulk(ctx.Request().Context(), h.fetcher, req.URLs, opts, nil)
+		if err != nil {
+			return mapServiceError(err)
+		}
+		return ctx.JSON(http.StatusOK, transport.SuccessResponse{
+			Success: true,
+			Data:    result,
+			Total:   result.Total,
+		})
+	}
+
+	return h.streamBulk(ctx, req.URLs, opts)
+}
+
+// streamBulk flushes one ndjson line per completed URL as Bulk's progress
+// callback fires, so clients can render progress without waiting for the
+// slowest document.
+func (h *Handler) streamBulk(ctx echo.Context, urls []string, opts service.BulkOptions) error {
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+	resp.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(resp)
+
+	result, err := h.svc.Bulk(ctx.Request().Context(), h.fetcher, urls, opts, func(doc *service.DocumentResult, fail *service.Failure) {
+		switch {
+		case fail != nil:
+			_ = enc.Encode(map[string]interface{}{"url": fail.URL, "error": fail.Error})
+		case doc != nil:
+			_ = enc.Encode(map[string]interface{}{"url": doc.URL, "total": doc.Total})
+		}
+		resp.Flush()
+	})
+	if err != nil {
+		return enc.Encode(map[string]interface{}{"error": err.Error()})
+	}
+
+	encErr := enc.Encode(map[string]interface{}{"done": true, "merged": result.Merged, "total": result.Total})
+	resp.Flush()
+	return encErr
+}
+
+// mapServiceError translates known service errors into echo.HTTPError so
+// transport.JSONErrorHandler renders a consistent envelope; anything else
+// passes through as a 500.
+func mapServiceError(err error) error {
+	if errors.Is(err, service.ErrEmptyInput) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo"
+
+	"github.com/sampila/simple-server/internal/service"
+)
+
+func TestMapServiceError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{"empty input maps to 422", service.ErrEmptyInput, http.StatusUnprocessableEntity},
+		{"unknown error passes through unchanged", errors.New("boom"), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapServiceError(tt.err)
+			he, ok := got.(*echo.HTTPError)
+			if tt.wantCode == 0 {
+				if ok {
+					t.Fatalf("mapServiceError(%v) = %v, want passthrough", tt.err, got)
+				}
+				return
+			}
+			if !ok || he.Code != tt.wantCode {
+				t.Fatalf("mapServiceError(%v) = %v, want HTTPError with code %d", tt.err, got, tt.wantCode)
+			}
+		})
+	}
+}
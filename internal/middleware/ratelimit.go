@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo"
+	"golang.org/x/time/rate"
+
+	"github.com/sampila/simple-server/internal/config"
+)
+
+// ipLimiterTTL is how long an IP's limiter is kept after its last request
+// before the sweeper reclaims it. Without this, one client hitting the
+// server from an ever-changing set of IPs (or a spoofed X-Forwarded-For)
+// could grow limiters without bound.
+const ipLimiterTTL = 10 * time.Minute
+
+// ipLimiterSweepInterval is how often the sweeper scans for expired
+// limiters.
+const ipLimiterSweepInterval = time.Minute
+
+// ipLimiterEntry pairs a limiter with the last time it was handed out, so
+// the sweeper can tell which entries are stale.
+type ipLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// ipLimiterStore hands out one token-bucket limiter per client IP,
+// creating it lazily on first use and evicting entries unused for
+// ipLimiterTTL.
+type ipLimiterStore struct {
+	mu       sync.Mutex
+	limiters map[string]*ipLimiterEntry
+	rps      rate.Limit
+	burst    int
+}
+
+func newIPLimiterStore(cfg config.RateLimitConfig) *ipLimiterStore {
+	s := &ipLimiterStore{
+		limiters: make(map[string]*ipLimiterEntry),
+		rps:      rate.Limit(cfg.RequestsPerSecond),
+		burst:    cfg.Burst,
+	}
+	go s.sweepLoop()
+	return s
+}
+
+func (s *ipLimiterStore) get(ip string) *rate.Limiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.limiters[ip]
+	if !ok {
+		entry = &ipLimiterEntry{limiter: rate.NewLimiter(s.rps, s.burst)}
+		s.limiters[ip] = entry
+	}
+	entry.lastSeen = time.Now()
+	return entry.limiter
+}
+
+// sweepLoop evicts limiters that haven't been used in ipLimiterTTL. It
+// runs for the lifetime of the process, same as the server itself.
+func (s *ipLimiterStore) sweepLoop() {
+	ticker := time.NewTicker(ipLimiterSweepInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		cutoff := time.Now().Add(-ipLimiterTTL)
+		s.mu.Lock()
+		for ip, entry := range s.limiters {
+			if entry.lastSeen.Before(cutoff) {
+				delete(s.limiters, ip)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+// RateLimiter throttles requests per client IP using a token-bucket
+// limiter (golang.org/x/time/rate), rejecting over-budget requests with
+// 429 Too Many Requests.
+func RateLimiter(cfg config.RateLimitConfig) echo.MiddlewareFunc {
+	store := newIPLimiterStore(cfg)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !store.get(ctx.RealIP()).Allow() {
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			}
+			return next(ctx)
+		}
+	}
+}
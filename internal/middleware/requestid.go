@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/labstack/echo"
+)
+
+// RequestIDHeader is the header every request/response carries a request
+// ID under.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID injects a request ID into the context and response, reusing
+// an inbound X-Request-ID if the caller (or an upstream proxy) already set
+// one so traces stay correlated end to end.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			id := ctx.Request().Header.Get(RequestIDHeader)
+			if id == "" {
+				id = newRequestID()
+			}
+
+			ctx.Set(RequestIDHeader, id)
+			ctx.Response().Header().Set(RequestIDHeader, id)
+			return next(ctx)
+		}
+	}
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "00000000000000000000000000000000"
+	}
+	return hex.EncodeToString(buf)
+}
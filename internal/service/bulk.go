@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// defaultBulkConcurrency bounds how many documents are fetched and
+// tokenized at once when a request doesn't specify its own value.
+const defaultBulkConcurrency = 8
+
+// Fetcher downloads the text at url. It's satisfied by *fetch.Client; the
+// interface lives here, on the consuming side, so Bulk can be unit-tested
+// against a fake without the service package importing net/http.
+type Fetcher interface {
+	Fetch(ctx context.Context, url string) (string, error)
+}
+
+// BulkOptions configures a Bulk call.
+type BulkOptions struct {
+	Options
+	Concurrency int
+}
+
+// DocumentResult is one successfully fetched and tokenized document.
+type DocumentResult struct {
+	URL   string `json:"url"`
+	Items []Item `json:"items"`
+	Total int    `json:"total"`
+}
+
+// Failure records a URL that could not be fetched or tokenized.
+type Failure struct {
+	URL   string `json:"url"`
+	Error string `json:"error"`
+}
+
+// BulkResult is the outcome of a Bulk call: the merged top-K across every
+// document, each document's own breakdown, and any failures.
+type BulkResult struct {
+	Merged    []Item           `json:"merged"`
+	Total     int              `json:"total"`
+	Documents []DocumentResult `json:"documents"`
+	Failures  []Failure        `json:"failures"`
+}
+
+// Bulk fetches every URL concurrently, bounded by opts.Concurrency
+// (defaulting to defaultBulkConcurrency), tokenizes each document on its
+// own goroutine, and merges every document's counts into one global tally
+// via a channel-fed reducer. A URL that fails to fetch, or yields no
+// words, is recorded in BulkResult.Failures instead of aborting the batch.
+//
+// If progress is non-nil it's called once per URL as soon as that URL
+// finishes - before the whole batch completes - so a caller can stream
+// results without waiting on the slowest document. Exactly one of its two
+// arguments is non-nil per call.
+func (s *wordCountService) Bulk(ctx context.Context, fetcher Fetcher, urls []string, opts BulkOptions, progress func(*DocumentResult, *Failure)) (BulkResult, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	type outcome struct {
+		doc    DocumentResult
+		fail   *Failure
+		counts map[string]int
+	}
+
+	sem := make(chan struct{}, concurrency)
+	results := make(chan outcome, len(urls))
+	var wg sync.WaitGroup
+
+	for _, docURL := range urls {
+		wg.Add(1)
+		go func(docURL string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			text, err := fetcher.Fetch(ctx, docURL)
+			if err != nil {
+				results <- outcome{fail: &Failure{URL: docURL, Error: err.Error()}}
+				return
+			}
+
+			counts, err := countWords(text, opts.Options)
+			if err != nil {
+				results <- outcome{fail: &Failure{URL: docURL, Error: err.Error()}}
+				return
+			}
+
+			items := rankItems(counts, clampK(opts.K))
+			results <- outcome{doc: DocumentResult{URL: docURL, Items: items, Total: len(items)}, counts: counts}
+		}(docURL)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	merged := make(map[string]int)
+	var documents []DocumentResult
+	var failures []Failure
+
+	for o := range results {
+		if o.fail != nil {
+			failures = append(failures, *o.fail)
+			if progress != nil {
+				progress(nil, o.fail)
+			}
+			continue
+		}
+
+		documents = append(documents, o.doc)
+		for word, total := range o.counts {
+			merged[word] += total
+		}
+		if progress != nil {
+			doc := o.doc
+			progress(&doc, nil)
+		}
+	}
+
+	if len(documents) == 0 {
+		return BulkResult{Failures: failures}, errors.New("no documents could be fetched")
+	}
+
+	mergedItems := rankItems(merged, clampK(opts.K))
+
+	return BulkResult{
+		Merged:    mergedItems,
+		Total:     len(mergedItems),
+		Documents: documents,
+		Failures:  failures,
+	}, nil
+}
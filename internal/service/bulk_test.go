@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type fakeFetcher struct {
+	docs map[string]string
+}
+
+func (f *fakeFetcher) Fetch(ctx context.Context, url string) (string, error) {
+	text, ok := f.docs[url]
+	if !ok {
+		return "", fmt.Errorf("404 for %s", url)
+	}
+	return text, nil
+}
+
+func TestBulk(t *testing.T) {
+	fetcher := &fakeFetcher{docs: map[string]string{
+		"https://a.example/doc": "go go js",
+		"https://b.example/doc": "go rust rust",
+	}}
+
+	svc := New().(*wordCountService)
+
+	var progressCalls int
+	result, err := svc.Bulk(context.Background(), fetcher,
+		[]string{"https://a.example/doc", "https://b.example/doc", "https://missing.example/doc"},
+		BulkOptions{Options: Options{K: 5}},
+		func(doc *DocumentResult, fail *Failure) { progressCalls++ },
+	)
+	if err != nil {
+		t.Fatalf("Bulk returned error: %v", err)
+	}
+
+	if progressCalls != 3 {
+		t.Fatalf("progress called %d times, want 3", progressCalls)
+	}
+	if len(result.Documents) != 2 {
+		t.Fatalf("len(Documents) = %d, want 2", len(result.Documents))
+	}
+	if len(result.Failures) != 1 || result.Failures[0].URL != "https://missing.example/doc" {
+		t.Fatalf("Failures = %+v, want one entry for the missing URL", result.Failures)
+	}
+
+	if result.Merged[0].Word != "go" || result.Merged[0].Total != 3 {
+		t.Fatalf("Merged[0] = %+v, want go:3", result.Merged[0])
+	}
+}
+
+func TestBulkAllFail(t *testing.T) {
+	fetcher := &fakeFetcher{docs: map[string]string{}}
+	svc := New().(*wordCountService)
+
+	_, err := svc.Bulk(context.Background(), fetcher, []string{"https://missing.example/doc"}, BulkOptions{}, nil)
+	if err == nil {
+		t.Fatal("expected an error when every URL fails")
+	}
+}
@@ -0,0 +1,141 @@
+// Package service holds the word-counting domain logic. It has no
+// knowledge of HTTP, Echo, or JSON so it can be unit-tested directly and
+// reused by any transport.
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ErrEmptyInput is returned when a request yields no tokens to count.
+var ErrEmptyInput = errors.New("no words found in input")
+
+// Options configures a single TopWords call.
+type Options struct {
+	K                int
+	CaseInsensitive  bool
+	StripPunctuation bool
+	MinLength        int
+	Stopwords        string
+	Locale           string
+}
+
+// Item is one ranked word and its count. Error is only set on results
+// produced by the approximate, streaming path and is the amount the count
+// could be overestimated by.
+type Item struct {
+	Word  string `json:"word"`
+	Total int    `json:"total"`
+	Error int    `json:"error,omitempty"`
+}
+
+// Result is the outcome of a TopWords/TopKWordsStream call.
+type Result struct {
+	Items []Item
+	Total int
+}
+
+// Service is the word-counting domain API. The HTTP handler layer talks
+// only to this interface, so it can be tested without spinning up Echo.
+type Service interface {
+	// TopWords tokenizes text per opts and returns the top opts.K words by
+	// exact count.
+	TopWords(ctx context.Context, text string, opts Options) (Result, error)
+	// TopKWordsStream scans r token-by-token through the Space-Saving
+	// algorithm, returning an approximate top-k in O(N) time and O(k)
+	// memory regardless of how large r is.
+	TopKWordsStream(ctx context.Context, r io.Reader, k int) (Result, error)
+	// Bulk fetches and tokenizes many documents concurrently, merging
+	// their counts into one top-K. See the method doc in bulk.go.
+	Bulk(ctx context.Context, fetcher Fetcher, urls []string, opts BulkOptions, progress func(*DocumentResult, *Failure)) (BulkResult, error)
+}
+
+type wordCountService struct{}
+
+// New returns the default Service implementation.
+func New() Service {
+	return &wordCountService{}
+}
+
+func (s *wordCountService) TopWords(ctx context.Context, text string, opts Options) (Result, error) {
+	counts, err := countWords(text, opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	items := rankItems(counts, clampK(opts.K))
+	return Result{Items: items, Total: len(items)}, nil
+}
+
+// countWords tokenizes text per opts and returns the full, untruncated
+// word->count map. It is shared by TopWords and Bulk, which need the
+// complete counts before any top-K truncation is applied.
+func countWords(text string, opts Options) (map[string]int, error) {
+	tokenizer := NewTokenizer(TokenizerOptions{
+		CaseInsensitive:  opts.CaseInsensitive,
+		StripPunctuation: opts.StripPunctuation,
+		MinLength:        opts.MinLength,
+		Stopwords:        opts.Stopwords,
+		Locale:           opts.Locale,
+	})
+
+	counts := make(map[string]int)
+	for _, word := range tokenizer.Tokenize(text) {
+		counts[word]++
+	}
+	if len(counts) == 0 {
+		return nil, ErrEmptyInput
+	}
+	return counts, nil
+}
+
+// rankItems sorts counts by descending total (ties broken by word for a
+// deterministic order) and truncates to the top k.
+func rankItems(counts map[string]int, k int) []Item {
+	items := make([]Item, 0, len(counts))
+	for w, c := range counts {
+		items = append(items, Item{Word: w, Total: c})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Total != items[j].Total {
+			return items[i].Total > items[j].Total
+		}
+		return items[i].Word < items[j].Word
+	})
+	if len(items) > k {
+		items = items[:k]
+	}
+	return items
+}
+
+func (s *wordCountService) TopKWordsStream(ctx context.Context, r io.Reader, k int) (Result, error) {
+	k = clampK(k)
+	ss := NewSpaceSaving(int(float64(k) / defaultEpsilon))
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		word := strings.TrimFunc(scanner.Text(), func(r rune) bool { return unicode.IsSpace(r) })
+		if len(word) < 1 {
+			continue
+		}
+		ss.Observe(word)
+	}
+	if err := scanner.Err(); err != nil {
+		return Result{}, err
+	}
+
+	items := ss.TopK(k)
+	if len(items) == 0 {
+		return Result{}, ErrEmptyInput
+	}
+
+	return Result{Items: items, Total: len(items)}, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// corpus builds a synthetic ~100MB text corpus out of a small vocabulary so
+// the benchmarks below exercise a realistic skew of repeated words without
+// shipping a huge fixture file.
+func corpus(targetBytes int) string {
+	vocab := []string{
+		"the", "quick", "brown", "fox", "jumps", "over", "lazy", "dog",
+		"go", "is", "a", "programming", "language", "built", "for", "scale",
+	}
+
+	var b strings.Builder
+	b.Grow(targetBytes)
+	i := 0
+	for b.Len() < targetBytes {
+		b.WriteString(vocab[i%len(vocab)])
+		b.WriteByte(' ')
+		i++
+	}
+	return b.String()
+}
+
+const benchCorpusSize = 100 * 1024 * 1024
+
+// BenchmarkExactTopWords measures the exact map+sort path against a 100MB
+// corpus.
+func BenchmarkExactTopWords(b *testing.B) {
+	svc := New()
+	text := corpus(benchCorpusSize)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		if _, err := svc.TopWords(context.Background(), text, Options{K: 10}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkApproximateTopKWordsStream measures the streaming Space-Saving
+// path against the same 100MB corpus.
+func BenchmarkApproximateTopKWordsStream(b *testing.B) {
+	svc := New()
+	text := corpus(benchCorpusSize)
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		if _, err := svc.TopKWordsStream(context.Background(), strings.NewReader(text), 10); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func ExampleSpaceSaving() {
+	ss := NewSpaceSaving(2)
+	for _, w := range []string{"a", "b", "a", "c", "a", "b"} {
+		ss.Observe(w)
+	}
+	for _, item := range ss.TopK(2) {
+		fmt.Println(item.Word, item.Total)
+	}
+	// Output:
+	// a 3
+	// b 3
+}
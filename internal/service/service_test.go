@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTopWords(t *testing.T) {
+	svc := New()
+
+	result, err := svc.TopWords(context.Background(), "go go js go js rust", Options{K: 2})
+	if err != nil {
+		t.Fatalf("TopWords returned error: %v", err)
+	}
+	if result.Total != 2 {
+		t.Fatalf("Total = %d, want 2", result.Total)
+	}
+	if result.Items[0].Word != "go" || result.Items[0].Total != 3 {
+		t.Fatalf("Items[0] = %+v, want go:3", result.Items[0])
+	}
+}
+
+func TestTopWordsEmptyInput(t *testing.T) {
+	svc := New()
+
+	if _, err := svc.TopWords(context.Background(), "   ", Options{}); err != ErrEmptyInput {
+		t.Fatalf("err = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestTopKWordsStream(t *testing.T) {
+	svc := New()
+
+	result, err := svc.TopKWordsStream(context.Background(), strings.NewReader("go go js go js rust"), 2)
+	if err != nil {
+		t.Fatalf("TopKWordsStream returned error: %v", err)
+	}
+	if result.Items[0].Word != "go" {
+		t.Fatalf("Items[0].Word = %q, want go", result.Items[0].Word)
+	}
+}
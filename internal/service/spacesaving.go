@@ -0,0 +1,109 @@
+package service
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// ssCounter tracks one of the m monitored words for the Space-Saving
+// algorithm. Error records the count the evicted word held when this slot
+// was reused, giving callers a guaranteed lower bound of count-error.
+type ssCounter struct {
+	word  string
+	count int
+	error int
+	index int
+}
+
+// ssHeap is a min-heap over ssCounter.count so the least-frequent tracked
+// word can always be found and evicted in O(log m).
+type ssHeap []*ssCounter
+
+func (h ssHeap) Len() int            { return len(h) }
+func (h ssHeap) Less(i, j int) bool  { return h[i].count < h[j].count }
+func (h ssHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i]; h[i].index = i; h[j].index = j }
+func (h *ssHeap) Push(x interface{}) {
+	c := x.(*ssCounter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+func (h *ssHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	old[n-1] = nil
+	c.index = -1
+	*h = old[:n-1]
+	return c
+}
+
+// SpaceSaving implements the Metwally et al. Space-Saving algorithm: it
+// estimates the top-K most frequent items from a stream in O(N) time using
+// only O(m) memory, where m = k/epsilon. A hash map gives O(1) lookup of a
+// tracked word and the heap gives O(log m) access to the current minimum.
+type SpaceSaving struct {
+	m        int
+	counters map[string]*ssCounter
+	heap     ssHeap
+}
+
+// defaultEpsilon drives the Space-Saving counter budget: m = k/epsilon, so
+// k=10 keeps 1000 counters, trading a bit more memory for tighter error
+// bounds on the reported counts.
+const defaultEpsilon = 0.01
+
+// NewSpaceSaving creates a tracker that keeps at most m counters.
+func NewSpaceSaving(m int) *SpaceSaving {
+	if m < 1 {
+		m = 1
+	}
+	return &SpaceSaving{
+		m:        m,
+		counters: make(map[string]*ssCounter, m),
+		heap:     make(ssHeap, 0, m),
+	}
+}
+
+// Observe records one occurrence of word.
+func (s *SpaceSaving) Observe(word string) {
+	if c, ok := s.counters[word]; ok {
+		c.count++
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+
+	if len(s.counters) < s.m {
+		c := &ssCounter{word: word, count: 1}
+		s.counters[word] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+
+	min := s.heap[0]
+	delete(s.counters, min.word)
+	min.word = word
+	min.error = min.count
+	min.count++
+	s.counters[min.word] = min
+	heap.Fix(&s.heap, min.index)
+}
+
+// TopK returns the k counters with the highest count, sorted descending.
+func (s *SpaceSaving) TopK(k int) []Item {
+	items := make([]Item, 0, len(s.counters))
+	for _, c := range s.counters {
+		items = append(items, Item{Word: c.word, Total: c.count, Error: c.error})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Total != items[j].Total {
+			return items[i].Total > items[j].Total
+		}
+		return items[i].Word < items[j].Word
+	})
+
+	if len(items) > k {
+		items = items[:k]
+	}
+	return items
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// defaultMinK and defaultMaxK clamp the user-supplied `k`.
+const (
+	defaultMinK = 1
+	defaultMaxK = 1000
+)
+
+var builtinStopwords = map[string]map[string]struct{}{
+	"en": wordSet("a", "an", "and", "are", "as", "at", "be", "by", "for", "from",
+		"in", "is", "it", "of", "on", "or", "that", "the", "this", "to", "was", "with"),
+	"id": wordSet("dan", "dari", "dengan", "di", "ini", "itu", "ke", "pada",
+		"untuk", "yang", "adalah", "akan", "atau"),
+}
+
+func wordSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+// TokenizerOptions configures how Tokenizer splits and filters text. It
+// mirrors the tokenizer options accepted on the word-count request.
+type TokenizerOptions struct {
+	CaseInsensitive  bool
+	StripPunctuation bool
+	MinLength        int
+	Stopwords        string // "", "none", "en", "id", or a comma-separated inline list
+	Locale           string
+}
+
+// Tokenizer splits text into words using Unicode letter/digit boundaries
+// instead of ASCII-only space-splitting, so accented Latin and mixed
+// scripts segment correctly. CJK scripts are split one character per
+// token since they carry no inter-word spaces and no dictionary
+// segmenter is available. Locale drives case-folding (via x/text/cases,
+// so e.g. Turkish "İ" folds correctly) rather than segmentation, then
+// punctuation, minimum-length and stopword filters apply per
+// TokenizerOptions.
+type Tokenizer struct {
+	opts  TokenizerOptions
+	stop  map[string]struct{}
+	langT language.Tag
+	caser cases.Caser
+}
+
+// NewTokenizer builds a Tokenizer for opts. An unrecognized Locale falls
+// back to language.Und rather than failing the request.
+func NewTokenizer(opts TokenizerOptions) *Tokenizer {
+	t := &Tokenizer{opts: opts}
+
+	if tag, err := language.Parse(opts.Locale); err == nil {
+		t.langT = tag
+	} else {
+		t.langT = language.Und
+	}
+	t.caser = cases.Lower(t.langT)
+
+	switch opts.Stopwords {
+	case "", "none":
+	case "en", "id":
+		t.stop = builtinStopwords[opts.Stopwords]
+	default:
+		// Fold with the same caser accept() matches against, not
+		// strings.ToLower, so locales with irregular casing (e.g.
+		// Turkish's dotless ı) don't silently stop matching an inline
+		// stopword.
+		words := strings.Split(opts.Stopwords, ",")
+		for i, w := range words {
+			words[i] = t.caser.String(strings.TrimSpace(w))
+		}
+		t.stop = wordSet(words...)
+	}
+
+	return t
+}
+
+// Tokenize returns the accepted, folded words found in text.
+func (t *Tokenizer) Tokenize(text string) []string {
+	normalized := norm.NFC.String(text)
+
+	var tokens []string
+	var cur strings.Builder
+
+	flush := func() {
+		if cur.Len() == 0 {
+			return
+		}
+		word := cur.String()
+		cur.Reset()
+		if t.accept(word) {
+			tokens = append(tokens, t.fold(word))
+		}
+	}
+
+	for _, r := range normalized {
+		switch {
+		case isUnspacedScript(r):
+			// Han, Hiragana, Katakana, and Hangul don't delimit words
+			// with spaces, so grouping by unicode.IsLetter alone would
+			// merge an entire CJK run into a single token. Without a
+			// dictionary segmenter, splitting on script boundaries - one
+			// token per character - is the best approximation available.
+			flush()
+			cur.WriteRune(r)
+			flush()
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			cur.WriteRune(r)
+		case !t.opts.StripPunctuation && (r == '\'' || r == '-') && cur.Len() > 0:
+			// Keep internal apostrophes/hyphens so "don't" and "well-known"
+			// stay single words unless the caller asked to strip punctuation.
+			cur.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// isUnspacedScript reports whether r belongs to a script that's
+// conventionally written without spaces between words.
+func isUnspacedScript(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+func (t *Tokenizer) fold(word string) string {
+	if t.opts.CaseInsensitive {
+		return t.caser.String(word)
+	}
+	return word
+}
+
+func (t *Tokenizer) accept(word string) bool {
+	if t.opts.MinLength > 0 && len([]rune(word)) < t.opts.MinLength {
+		return false
+	}
+	if t.stop != nil {
+		// Stopword matching always case-folds, independent of
+		// CaseInsensitive (which only controls the case of emitted
+		// words), so "The" is still filtered when the caller wants
+		// output left in its original case.
+		if _, ok := t.stop[t.caser.String(word)]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// clampK clamps k to [defaultMinK, defaultMaxK], defaulting to 10 when k<=0.
+func clampK(k int) int {
+	if k <= 0 {
+		k = 10
+	}
+	if k < defaultMinK {
+		return defaultMinK
+	}
+	if k > defaultMaxK {
+		return defaultMaxK
+	}
+	return k
+}
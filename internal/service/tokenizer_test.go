@@ -0,0 +1,123 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenizer(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		opts TokenizerOptions
+		want []string
+	}{
+		{
+			name: "default splits on punctuation and keeps case",
+			text: "The quick brown fox. Go go!",
+			opts: TokenizerOptions{},
+			want: []string{"The", "quick", "brown", "fox", "Go", "go"},
+		},
+		{
+			name: "case_insensitive folds to lowercase",
+			text: "Go go GO",
+			opts: TokenizerOptions{CaseInsensitive: true},
+			want: []string{"go", "go", "go"},
+		},
+		{
+			name: "strip_punctuation drops internal apostrophes and hyphens",
+			text: "don't stop well-known",
+			opts: TokenizerOptions{StripPunctuation: true},
+			want: []string{"don", "t", "stop", "well", "known"},
+		},
+		{
+			name: "without strip_punctuation apostrophes and hyphens are kept",
+			text: "don't stop well-known",
+			opts: TokenizerOptions{},
+			want: []string{"don't", "stop", "well-known"},
+		},
+		{
+			name: "min_length filters short tokens",
+			text: "a bb ccc dddd",
+			opts: TokenizerOptions{MinLength: 3},
+			want: []string{"ccc", "dddd"},
+		},
+		{
+			name: "stopwords en filters common English words",
+			text: "the fox and the dog",
+			opts: TokenizerOptions{CaseInsensitive: true, Stopwords: "en"},
+			want: []string{"fox", "dog"},
+		},
+		{
+			name: "stopwords id filters common Indonesian words",
+			text: "kucing dan anjing yang lucu",
+			opts: TokenizerOptions{CaseInsensitive: true, Stopwords: "id"},
+			want: []string{"kucing", "anjing", "lucu"},
+		},
+		{
+			name: "inline stopword list",
+			text: "red green blue red",
+			opts: TokenizerOptions{Stopwords: "red,blue"},
+			want: []string{"green"},
+		},
+		{
+			name: "unicode letters from accented Latin are kept together",
+			text: "café naïve",
+			opts: TokenizerOptions{},
+			want: []string{"café", "naïve"},
+		},
+		{
+			name: "unknown locale falls back instead of failing",
+			text: "hello world",
+			opts: TokenizerOptions{Locale: "not-a-real-locale"},
+			want: []string{"hello", "world"},
+		},
+		{
+			name: "CJK runs split one character per token",
+			text: "我喜欢编程 我 喜欢 Go语言",
+			opts: TokenizerOptions{},
+			want: []string{"我", "喜", "欢", "编", "程", "我", "喜", "欢", "Go", "语", "言"},
+		},
+		{
+			name: "locale drives case-insensitive folding",
+			text: "İstanbul",
+			opts: TokenizerOptions{CaseInsensitive: true, Locale: "tr"},
+			want: []string{"istanbul"},
+		},
+		{
+			name: "inline stopword folds with the same locale-aware caser as matching",
+			text: "Istanbul is old",
+			opts: TokenizerOptions{Locale: "tr", Stopwords: "Istanbul"},
+			want: []string{"is", "old"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTokenizer(tt.opts).Tokenize(tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClampK(t *testing.T) {
+	tests := []struct {
+		in   int
+		want int
+	}{
+		{0, 10},
+		{-5, 10},
+		{1, 1},
+		{10, 10},
+		{1000, 1000},
+		{5000, 1000},
+	}
+
+	for _, tt := range tests {
+		if got := clampK(tt.in); got != tt.want {
+			t.Errorf("clampK(%d) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
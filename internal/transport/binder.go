@@ -0,0 +1,157 @@
+package transport
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo"
+
+	"github.com/sampila/simple-server/internal/fetch"
+)
+
+// defaultMaxFetchBytes caps uploaded files so a single request can't
+// exhaust server memory; ?url= fetches use fetch.DefaultMaxBytes.
+const defaultMaxFetchBytes = fetch.DefaultMaxBytes
+
+// remoteFetcher is shared across requests per Go's http.Client docs, which
+// recommend reusing a client rather than creating one per call.
+var remoteFetcher = fetch.New(fetch.DefaultTimeout, fetch.DefaultMaxBytes)
+
+// WordCountBinder extends echo's DefaultBinder so WordCountRequest can be
+// populated from JSON, XML, urlencoded/multipart form fields (including an
+// uploaded `file`), a GET query string, or a `url` to fetch remotely. It
+// falls back to echo.DefaultBinder for any other request type so future
+// endpoints keep working unmodified.
+type WordCountBinder struct {
+	echo.DefaultBinder
+}
+
+func (b *WordCountBinder) Bind(i interface{}, ctx echo.Context) error {
+	form, ok := i.(*WordCountRequest)
+	if !ok {
+		return b.DefaultBinder.Bind(i, ctx)
+	}
+
+	req := ctx.Request()
+	ctype := req.Header.Get(echo.HeaderContentType)
+
+	switch {
+	case req.Method == http.MethodGet:
+		bindWordCountQuery(form, ctx.QueryParams())
+
+	case strings.HasPrefix(ctype, echo.MIMEApplicationJSON):
+		if err := json.NewDecoder(req.Body).Decode(form); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+	case strings.HasPrefix(ctype, echo.MIMEApplicationXML), strings.HasPrefix(ctype, echo.MIMETextXML):
+		if err := xml.NewDecoder(req.Body).Decode(form); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+	case strings.HasPrefix(ctype, echo.MIMEApplicationForm):
+		if err := req.ParseForm(); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		bindWordCountQuery(form, req.Form)
+
+	case strings.HasPrefix(ctype, echo.MIMEMultipartForm):
+		if err := req.ParseMultipartForm(defaultMaxFetchBytes); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		bindWordCountQuery(form, req.Form)
+		if fh, err := ctx.FormFile("file"); err == nil {
+			form.File = fh
+		}
+
+	default:
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "unsupported content type: "+ctype)
+	}
+
+	if err := validateSourceExclusivity(form); err != nil {
+		return err
+	}
+
+	if form.URL != "" {
+		text, err := remoteFetcher.Fetch(req.Context(), form.URL)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadGateway, err.Error())
+		}
+		form.Text = text
+		form.URL = ""
+	}
+
+	if form.File != nil {
+		text, err := readUploadedFile(form.File)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		form.Text = text
+		form.File = nil
+	}
+
+	return nil
+}
+
+// validateSourceExclusivity rejects a request that supplies more than one
+// of text/file/url; exactly which one "wins" would otherwise depend on
+// bind order rather than being a deliberate choice. It runs before the
+// url is fetched or the file is read, so the three fields still reflect
+// what the caller actually sent - once resolved into form.Text, Bind
+// clears URL/File, so this can't run again post-resolution and reject a
+// legitimate single-source request.
+func validateSourceExclusivity(form *WordCountRequest) error {
+	sources := 0
+	if form.Text != "" {
+		sources++
+	}
+	if form.URL != "" {
+		sources++
+	}
+	if form.File != nil {
+		sources++
+	}
+	if sources > 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "text, file, and url are mutually exclusive")
+	}
+	return nil
+}
+
+// bindWordCountQuery copies the tokenizer and source fields shared by the
+// GET query string and both urlencoded/multipart form paths into form.
+func bindWordCountQuery(form *WordCountRequest, values url.Values) {
+	form.Text = values.Get("text")
+	form.URL = values.Get("url")
+	form.CaseInsensitive, _ = strconv.ParseBool(values.Get("case_insensitive"))
+	form.StripPunctuation, _ = strconv.ParseBool(values.Get("strip_punctuation"))
+	form.Stopwords = values.Get("stopwords")
+	form.Locale = values.Get("locale")
+	if k, err := strconv.Atoi(values.Get("k")); err == nil {
+		form.K = k
+	}
+	if minLength, err := strconv.Atoi(values.Get("min_length")); err == nil {
+		form.MinLength = minLength
+	}
+}
+
+// readUploadedFile reads at most defaultMaxFetchBytes from an uploaded
+// multipart file.
+func readUploadedFile(fh *multipart.FileHeader) (string, error) {
+	f, err := fh.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	body, err := io.ReadAll(io.LimitReader(f, defaultMaxFetchBytes))
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kaorimatz/go-opml"
+	"github.com/labstack/echo"
+)
+
+// BulkRequest is the payload accepted by POST /top-ten-words/bulk: either
+// a JSON body listing URLs directly, or a multipart upload of an OPML
+// file whose outlines' xmlUrl attributes are used as the URL list.
+type BulkRequest struct {
+	URLs        []string `json:"urls"`
+	K           int      `json:"k"`
+	Concurrency int      `json:"concurrency"`
+}
+
+// ParseBulkRequest binds a BulkRequest from either application/json or a
+// multipart "file" field containing an OPML document.
+func ParseBulkRequest(ctx echo.Context) (*BulkRequest, error) {
+	req := ctx.Request()
+	ctype := req.Header.Get(echo.HeaderContentType)
+
+	if strings.HasPrefix(ctype, echo.MIMEMultipartForm) {
+		return parseBulkMultipart(ctx)
+	}
+
+	form := new(BulkRequest)
+	if err := json.NewDecoder(req.Body).Decode(form); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return form, nil
+}
+
+func parseBulkMultipart(ctx echo.Context) (*BulkRequest, error) {
+	req := ctx.Request()
+	if err := req.ParseMultipartForm(defaultMaxFetchBytes); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	form := new(BulkRequest)
+	if k, err := strconv.Atoi(req.FormValue("k")); err == nil {
+		form.K = k
+	}
+	if concurrency, err := strconv.Atoi(req.FormValue("concurrency")); err == nil {
+		form.Concurrency = concurrency
+	}
+
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "missing OPML file field")
+	}
+
+	urls, err := opmlFeedURLs(fh)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	form.URLs = urls
+
+	return form, nil
+}
+
+// opmlFeedURLs reads fh as an OPML document and returns every outline's
+// xmlUrl, walking nested outlines recursively.
+func opmlFeedURLs(fh *multipart.FileHeader) ([]string, error) {
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, err := opml.Parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []string
+	var walk func(outlines []*opml.Outline)
+	walk = func(outlines []*opml.Outline) {
+		for _, o := range outlines {
+			if o.XMLURL != nil {
+				urls = append(urls, o.XMLURL.String())
+			}
+			walk(o.Outlines)
+		}
+	}
+	walk(doc.Outlines)
+
+	return urls, nil
+}
@@ -0,0 +1,51 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+)
+
+// ErrorResponse is the common error envelope: {success:false, error:{code,message}}.
+type ErrorResponse struct {
+	Success bool        `json:"success"`
+	Error   ErrorDetail `json:"error"`
+}
+
+type ErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// JSONErrorHandler replaces echo's default HTTPErrorHandler so every error
+// - whether an *echo.HTTPError raised by a handler/middleware, or a panic
+// recovered by middleware.Recover() - renders through the same
+// {success:false, error:{code,message}} envelope.
+func JSONErrorHandler(err error, ctx echo.Context) {
+	code := http.StatusInternalServerError
+	message := http.StatusText(code)
+
+	if he, ok := err.(*echo.HTTPError); ok {
+		code = he.Code
+		if msg, ok := he.Message.(string); ok {
+			message = msg
+		} else {
+			message = http.StatusText(code)
+		}
+	} else if err != nil {
+		message = err.Error()
+	}
+
+	if ctx.Response().Committed {
+		return
+	}
+
+	resp := ErrorResponse{
+		Success: false,
+		Error:   ErrorDetail{Code: code, Message: message},
+	}
+
+	if werr := ctx.JSON(code, resp); werr != nil {
+		ctx.Logger().Error(werr)
+	}
+}
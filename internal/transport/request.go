@@ -0,0 +1,30 @@
+// Package transport holds the HTTP-facing request/response shapes and the
+// Echo Binder/Validator/error-handler wiring shared by every handler.
+package transport
+
+import (
+	"encoding/xml"
+	"mime/multipart"
+)
+
+// WordCountRequest is the payload accepted by the top-words endpoints. It
+// is populated by WordCountBinder from JSON, XML, form, multipart, GET
+// query, or a fetched ?url=.
+type WordCountRequest struct {
+	XMLName xml.Name `xml:"request" json:"-" form:"-" validate:"-"`
+
+	// Mutual exclusion between Text/File/URL is enforced by
+	// validateSourceExclusivity in binder.go rather than validate tags:
+	// go-playground/validator v9 (pinned in go.mod) has no excluded_with
+	// tag - that was added in v10.
+	Text string                `json:"text" xml:"text" form:"text" validate:"required_without_all=File URL"`
+	File *multipart.FileHeader `json:"-" xml:"-" form:"-"`
+	URL  string                `json:"url" xml:"url" form:"url"`
+
+	K                int    `json:"k" xml:"k" form:"k" validate:"omitempty,min=1,max=1000"`
+	CaseInsensitive  bool   `json:"case_insensitive" xml:"case_insensitive" form:"case_insensitive"`
+	StripPunctuation bool   `json:"strip_punctuation" xml:"strip_punctuation" form:"strip_punctuation"`
+	MinLength        int    `json:"min_length" xml:"min_length" form:"min_length" validate:"omitempty,min=0"`
+	Stopwords        string `json:"stopwords" xml:"stopwords" form:"stopwords"`
+	Locale           string `json:"locale" xml:"locale" form:"locale"`
+}
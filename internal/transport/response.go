@@ -0,0 +1,9 @@
+package transport
+
+// SuccessResponse is the common successful-response envelope returned by
+// every word-count endpoint.
+type SuccessResponse struct {
+	Success bool        `json:"success"`
+	Data    interface{} `json:"data"`
+	Total   int         `json:"total"`
+}
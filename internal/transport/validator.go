@@ -0,0 +1,20 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/go-playground/validator"
+	"github.com/labstack/echo"
+)
+
+// CustomValidator adapts go-playground/validator to echo.Validator.
+type CustomValidator struct {
+	Validator *validator.Validate
+}
+
+func (cv *CustomValidator) Validate(i interface{}) error {
+	if err := cv.Validator.Struct(i); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return nil
+}